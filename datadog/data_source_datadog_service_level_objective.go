@@ -1,13 +1,24 @@
 package datadog
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 )
 
+// historyWindowSeconds maps the supported `history_window` values to their length in seconds.
+var historyWindowSeconds = map[string]int64{
+	"7d":  7 * 24 * 60 * 60,
+	"30d": 30 * 24 * 60 * 60,
+	"90d": 90 * 24 * 60 * 60,
+}
+
 func dataSourceDatadogServiceLevelObjective() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to retrieve information about an existing monitor for use in other resources.",
@@ -30,8 +41,24 @@ func dataSourceDatadogServiceLevelObjective() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"id": {
+				Description: "ID of the Datadog service level objective. If set, `id` takes precedence over all other filters, and the SLO is looked up directly rather than through `ListSLOs`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"multiple": {
+				Description: "When `true` and more than one result matches the given `name_query`, `tags_filter` and/or `metrics_query`, all matches are returned as a list under the computed `slos` attribute instead of raising an error. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 
 			// Computed values
+			"slos": {
+				Description: "List of SLOs matching the search criteria, when `multiple` is `true`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        sloListItemResource(),
+			},
 			"name": {
 				Description: "Name of Datadog service level objective",
 				Type:        schema.TypeString,
@@ -92,6 +119,62 @@ func dataSourceDatadogServiceLevelObjective() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"timeframe": {
+				Description: "The primary time frame for the objective, as reported by the SLO, or from the first entry of `thresholds` when the SLO does not report one.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"target_threshold": {
+				Description: "The primary target for the objective, as reported by the SLO, or from the first entry of `thresholds` when the SLO does not report one.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"warning_threshold": {
+				Description: "The primary warning value for the objective, as reported by the SLO, or from the first entry of `thresholds` when the SLO does not report one.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"history_window": {
+				Description:  "The time window to use when computing `error_budget`. Available options to choose from are: `7d`, `30d`, `90d`. Defaults to `7d`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"7d", "30d", "90d"}, false),
+			},
+			"error_budget": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "The error budget for the SLO over `history_window`, derived from the SLO history endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sli_value": {
+							Description: "The achieved SLI value over `history_window`. Null if the SLO has no history yet.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"target": {
+							Description: "The SLO's target in `[0,100]`.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"budget_remaining_percentage": {
+							Description: "The percentage of the error budget remaining, computed as `(sli_value - target) / (100 - target) * 100`.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"budget_consumed_percentage": {
+							Description: "The percentage of the error budget consumed, i.e. `100 - budget_remaining_percentage`.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"error_budget_seconds_remaining": {
+							Description: "For time-based SLOs, the number of seconds of error budget remaining over `history_window`.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			// Metric-Based SLO
 			"query": {
 				Type:        schema.TypeList,
@@ -136,38 +219,172 @@ func dataSourceDatadogServiceLevelObjective() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			// Time-Slice SLO
+			"sli_specification": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Computed:    true,
+				Description: "A map of SLI specifications to compute the SLI value for time-slice SLOs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_slice": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Computed:    true,
+							Description: "The time slice condition, representing the formula and threshold.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Computed:    true,
+										Description: "The queries and formula used to calculate the SLI value.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"formula": {
+													Type:        schema.TypeList,
+													MaxItems:    1,
+													Computed:    true,
+													Description: "The formula used to calculate the SLI value.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"formula_expression": {
+																Description: "The formula string, referencing the named queries.",
+																Type:        schema.TypeString,
+																Computed:    true,
+															},
+														},
+													},
+												},
+												"queries": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Description: "The named metric queries referenced by the formula.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Description: "The name of the query, used by the formula to refer to it.",
+																Type:        schema.TypeString,
+																Computed:    true,
+															},
+															"query": {
+																Description: "The metric query.",
+																Type:        schema.TypeString,
+																Computed:    true,
+															},
+															"data_source": {
+																Description: "The source of the queried metric.",
+																Type:        schema.TypeString,
+																Computed:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"comparator": {
+										Description: "The comparator used to compare the SLI value to the threshold. One of `>`, `>=`, `<`, `<=`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"threshold": {
+										Description: "The threshold the SLI value is compared to.",
+										Type:        schema.TypeFloat,
+										Computed:    true,
+									},
+									"query_interval_seconds": {
+										Description: "The size of the window to evaluate for each time slice, in seconds. Available values: `60`, `300`.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// primarySLOThresholdFields flattens an SLO's primary timeframe/target/warning, preferring the
+// SLO's own convenience accessors and falling back to the first entry of its typed thresholds.
+func primarySLOThresholdFields(slo datadogV1.ServiceLevelObjective) (timeframe string, target float64, warning float64) {
+	rawThresholds := slo.GetThresholds()
+
+	if v, ok := slo.GetTimeframeOk(); ok {
+		timeframe = string(*v)
+	} else if len(rawThresholds) > 0 {
+		timeframe = string(rawThresholds[0].GetTimeframe())
+	}
+	if v, ok := slo.GetTargetThresholdOk(); ok {
+		target = *v
+	} else if len(rawThresholds) > 0 {
+		target = rawThresholds[0].GetTarget()
+	}
+	if v, ok := slo.GetWarningThresholdOk(); ok {
+		warning = *v
+	} else if len(rawThresholds) > 0 {
+		if w, ok := rawThresholds[0].GetWarningOk(); ok {
+			warning = *w
+		}
+	}
+	return timeframe, target, warning
+}
+
 func dataSourceServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
 	authV1 := providerConf.AuthV1
 
-	req := datadogClientV1.ServiceLevelObjectivesApi.ListSLOs(authV1)
-	if v, ok := d.GetOk("name_query"); ok {
-		req = req.Query(v.(string))
-	}
-	if v, ok := d.GetOk("tags_filter"); ok {
-		req = req.TagsQuery(v.(string))
-	}
-	if v, ok := d.GetOk("metrics_query"); ok {
-		req = req.MetricsQuery(v.(string))
-	}
+	var slos []datadogV1.ServiceLevelObjective
+	if v, ok := d.GetOk("id"); ok {
+		sloResponse, _, err := datadogClientV1.ServiceLevelObjectivesApi.GetSLO(authV1, v.(string)).Execute()
+		if err != nil {
+			return utils.TranslateClientError(err, "error querying SLO")
+		}
+		slos = []datadogV1.ServiceLevelObjective{sloResponse.GetData()}
+	} else {
+		req := datadogClientV1.ServiceLevelObjectivesApi.ListSLOs(authV1)
+		if v, ok := d.GetOk("name_query"); ok {
+			req = req.Query(v.(string))
+		}
+		if v, ok := d.GetOk("tags_filter"); ok {
+			req = req.TagsQuery(v.(string))
+		}
+		if v, ok := d.GetOk("metrics_query"); ok {
+			req = req.MetricsQuery(v.(string))
+		}
 
-	sloResponse, _, err := req.Execute()
-	if err != nil {
-		return utils.TranslateClientError(err, "error querying monitors")
-	}
+		sloResponse, _, err := req.Execute()
+		if err != nil {
+			return utils.TranslateClientError(err, "error querying monitors")
+		}
 
-	slos := sloResponse.GetData()
-	if len(slos) > 1 {
-		return fmt.Errorf("your query returned more than one result, please try a more specific search criteria")
+		slos = sloResponse.GetData()
 	}
+
 	if len(slos) == 0 {
 		return fmt.Errorf("your query returned no result, please try a less specific search criteria")
 	}
+	multiple := d.Get("multiple").(bool)
+	if multiple {
+		matches := make([]map[string]interface{}, 0, len(slos))
+		for _, match := range slos {
+			matches = append(matches, buildTerraformSLOListItem(match))
+		}
+		if err := d.Set("slos", matches); err != nil {
+			return err
+		}
+	}
+	if len(slos) > 1 {
+		if !multiple {
+			return fmt.Errorf("your query returned more than one result, please try a more specific search criteria, or set `multiple` to true to see all matches")
+		}
+		d.SetId(resource.UniqueId())
+		return nil
+	}
 
 	slo := slos[0]
 
@@ -209,6 +426,18 @@ func dataSourceServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{
 	if err := d.Set("thresholds", thresholds); err != nil {
 		return err
 	}
+
+	timeframe, targetThreshold, warningThreshold := primarySLOThresholdFields(slo)
+	if err := d.Set("timeframe", timeframe); err != nil {
+		return err
+	}
+	if err := d.Set("target_threshold", targetThreshold); err != nil {
+		return err
+	}
+	if err := d.Set("warning_threshold", warningThreshold); err != nil {
+		return err
+	}
+
 	switch slo.GetType() {
 	case datadogV1.SLOTYPE_MONITOR:
 		// monitor type
@@ -220,6 +449,16 @@ func dataSourceServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{
 		if err := d.Set("groups", slo.GetGroups()); err != nil {
 			return err
 		}
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		// time-slice type
+		sliSpec := slo.GetSliSpecification()
+		terraformSliSpec, err := buildTerraformSliSpecification(sliSpec)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("sli_specification", []map[string]interface{}{terraformSliSpec}); err != nil {
+			return err
+		}
 	default:
 		// metric type
 		query := make(map[string]interface{})
@@ -230,7 +469,113 @@ func dataSourceServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{
 			return err
 		}
 	}
+
+	errorBudget, err := buildTerraformErrorBudget(d, datadogClientV1, authV1, slo.GetId(), targetThreshold)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("error_budget", errorBudget); err != nil {
+		return err
+	}
+
 	d.SetId(slo.GetId())
 
 	return nil
 }
+
+// buildTerraformErrorBudget calls the SLO history endpoint over `history_window` and derives the
+// error budget fields from the achieved SLI value and the SLO's target threshold.
+func buildTerraformErrorBudget(d *schema.ResourceData, datadogClientV1 *datadogV1.APIClient, authV1 context.Context, sloID string, target float64) ([]map[string]interface{}, error) {
+	window := "7d"
+	if v, ok := d.GetOk("history_window"); ok {
+		window = v.(string)
+	}
+	windowSeconds := historyWindowSeconds[window]
+
+	toTs := time.Now().Unix()
+	fromTs := toTs - windowSeconds
+
+	historyResponse, _, err := datadogClientV1.ServiceLevelObjectivesApi.GetSLOHistory(authV1, sloID).FromTs(fromTs).ToTs(toTs).Execute()
+	if err != nil {
+		return nil, utils.TranslateClientError(err, "error querying SLO history")
+	}
+
+	overall := historyResponse.GetData().GetOverall()
+	sliValue, ok := overall.GetSliValueOk()
+	if !ok || sliValue == nil {
+		return []map[string]interface{}{{"target": target}}, nil
+	}
+
+	return []map[string]interface{}{computeErrorBudget(*sliValue, target, windowSeconds)}, nil
+}
+
+// computeErrorBudget derives the error budget percentages and (for time-based windows) the
+// remaining error budget in seconds from the achieved SLI value and the SLO's target threshold.
+// Kept free of API calls so the arithmetic can be unit tested directly.
+func computeErrorBudget(sliValue float64, target float64, windowSeconds int64) map[string]interface{} {
+	errorBudget := map[string]interface{}{
+		"sli_value": sliValue,
+		"target":    target,
+	}
+
+	// A 100% target has no error budget to divide by; treat it as fully remaining/unconsumed
+	// rather than dividing by zero.
+	if target >= 100 {
+		errorBudget["budget_remaining_percentage"] = 100.0
+		errorBudget["budget_consumed_percentage"] = 0.0
+	} else {
+		budgetRemainingPct := (sliValue - target) / (100 - target) * 100
+		errorBudget["budget_remaining_percentage"] = budgetRemainingPct
+		errorBudget["budget_consumed_percentage"] = 100 - budgetRemainingPct
+	}
+
+	if windowSeconds > 0 {
+		targetSeconds := (1 - target/100) * float64(windowSeconds)
+		consumedSeconds := (1 - sliValue/100) * float64(windowSeconds)
+		errorBudget["error_budget_seconds_remaining"] = int(targetSeconds - consumedSeconds)
+	}
+
+	return errorBudget
+}
+
+func buildTerraformSliSpecification(sliSpec datadogV1.SLOSliSpecification) (map[string]interface{}, error) {
+	timeSlice := sliSpec.GetTimeSlice()
+
+	formulaQuery := timeSlice.GetQuery()
+	formulas := formulaQuery.GetFormulas()
+	if len(formulas) == 0 {
+		return nil, fmt.Errorf("time-slice SLO query has no formulas")
+	}
+	formula := formulas[0]
+
+	queries := make([]map[string]interface{}, 0)
+	for _, q := range formulaQuery.GetQueries() {
+		metricQuery := q.SLOFormulaAndFunctionMetricQueryDefinition
+		if metricQuery == nil {
+			return nil, fmt.Errorf("time-slice SLO query contains an unsupported query type")
+		}
+		queries = append(queries, map[string]interface{}{
+			"name":        metricQuery.GetName(),
+			"query":       metricQuery.GetQuery(),
+			"data_source": metricQuery.GetDataSource(),
+		})
+	}
+
+	query := map[string]interface{}{
+		"formula": []map[string]interface{}{
+			{"formula_expression": formula.GetFormula()},
+		},
+		"queries": queries,
+	}
+
+	return map[string]interface{}{
+		"time_slice": []map[string]interface{}{
+			{
+				"query":                  []map[string]interface{}{query},
+				"comparator":             timeSlice.GetComparator(),
+				"threshold":              timeSlice.GetThreshold(),
+				"query_interval_seconds": timeSlice.GetQueryIntervalSeconds(),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,45 @@
+package datadog
+
+import "testing"
+
+func TestComputeErrorBudget_Normal(t *testing.T) {
+	result := computeErrorBudget(99.95, 99.0, 7*24*60*60)
+
+	if result["sli_value"] != 99.95 {
+		t.Errorf("unexpected sli_value: %v", result["sli_value"])
+	}
+	remaining := result["budget_remaining_percentage"].(float64)
+	if remaining <= 0 || remaining >= 100 {
+		t.Errorf("expected budget_remaining_percentage in (0,100), got %v", remaining)
+	}
+	if _, ok := result["error_budget_seconds_remaining"]; !ok {
+		t.Errorf("expected error_budget_seconds_remaining to be set for a non-zero window")
+	}
+}
+
+func TestComputeErrorBudget_HundredPercentTargetDoesNotDivideByZero(t *testing.T) {
+	result := computeErrorBudget(100, 100, 7*24*60*60)
+
+	if result["budget_remaining_percentage"] != 100.0 {
+		t.Errorf("expected budget_remaining_percentage 100, got %v", result["budget_remaining_percentage"])
+	}
+	if result["budget_consumed_percentage"] != 0.0 {
+		t.Errorf("expected budget_consumed_percentage 0, got %v", result["budget_consumed_percentage"])
+	}
+}
+
+func TestComputeErrorBudget_PartialSLIAtHundredPercentTarget(t *testing.T) {
+	result := computeErrorBudget(99.5, 100, 7*24*60*60)
+
+	if result["budget_remaining_percentage"] != 100.0 {
+		t.Errorf("expected budget_remaining_percentage 100 for a 100%% target, got %v", result["budget_remaining_percentage"])
+	}
+}
+
+func TestComputeErrorBudget_ZeroWindowOmitsSecondsRemaining(t *testing.T) {
+	result := computeErrorBudget(99.95, 99.0, 0)
+
+	if _, ok := result["error_budget_seconds_remaining"]; ok {
+		t.Errorf("did not expect error_budget_seconds_remaining for a zero-length window")
+	}
+}
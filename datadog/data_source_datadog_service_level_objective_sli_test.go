@@ -0,0 +1,78 @@
+package datadog
+
+import (
+	"testing"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+)
+
+func buildTestSliSpecification(queries []datadogV1.SLOFormulaAndFunctionQueryDefinition, formulas []datadogV1.SLOFormula) datadogV1.SLOSliSpecification {
+	formulaQuery := datadogV1.SLOFormulaAndFunctionSliDataSourceQueryDefinition{}
+	formulaQuery.SetFormulas(formulas)
+	formulaQuery.SetQueries(queries)
+
+	timeSlice := datadogV1.SLOTimeSliceCondition{}
+	timeSlice.SetQuery(formulaQuery)
+	timeSlice.SetComparator(datadogV1.SLOTIMESLICECOMPARATOR_GT)
+	timeSlice.SetThreshold(99.9)
+	timeSlice.SetQueryIntervalSeconds(300)
+
+	sliSpec := datadogV1.SLOSliSpecification{}
+	sliSpec.SetTimeSlice(timeSlice)
+	return sliSpec
+}
+
+func TestBuildTerraformSliSpecification_Success(t *testing.T) {
+	metricQuery := datadogV1.NewSLOFormulaAndFunctionMetricQueryDefinitionWithDefaults()
+	metricQuery.SetName("query1")
+	metricQuery.SetQuery("sum:requests.good{*}")
+	metricQuery.SetDataSource(datadogV1.SLOFORMULAANDFUNCTIONMETRICDATASOURCE_METRICS)
+
+	sliSpec := buildTestSliSpecification(
+		[]datadogV1.SLOFormulaAndFunctionQueryDefinition{{SLOFormulaAndFunctionMetricQueryDefinition: metricQuery}},
+		[]datadogV1.SLOFormula{{Formula: "query1"}},
+	)
+
+	result, err := buildTerraformSliSpecification(sliSpec)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	timeSlice := result["time_slice"].([]map[string]interface{})[0]
+	if timeSlice["comparator"] != datadogV1.SLOTIMESLICECOMPARATOR_GT {
+		t.Errorf("unexpected comparator: %v", timeSlice["comparator"])
+	}
+	if timeSlice["threshold"] != 99.9 {
+		t.Errorf("unexpected threshold: %v", timeSlice["threshold"])
+	}
+
+	query := timeSlice["query"].([]map[string]interface{})[0]
+	formula := query["formula"].([]map[string]interface{})[0]
+	if formula["formula_expression"] != "query1" {
+		t.Errorf("unexpected formula expression: %v", formula["formula_expression"])
+	}
+
+	queries := query["queries"].([]map[string]interface{})
+	if len(queries) != 1 || queries[0]["name"] != "query1" {
+		t.Errorf("unexpected queries: %v", queries)
+	}
+}
+
+func TestBuildTerraformSliSpecification_NoFormulas(t *testing.T) {
+	sliSpec := buildTestSliSpecification(nil, nil)
+
+	if _, err := buildTerraformSliSpecification(sliSpec); err == nil {
+		t.Fatal("expected an error for a time-slice SLO with no formulas, got none")
+	}
+}
+
+func TestBuildTerraformSliSpecification_UnsupportedQueryType(t *testing.T) {
+	sliSpec := buildTestSliSpecification(
+		[]datadogV1.SLOFormulaAndFunctionQueryDefinition{{}},
+		[]datadogV1.SLOFormula{{Formula: "query1"}},
+	)
+
+	if _, err := buildTerraformSliSpecification(sliSpec); err == nil {
+		t.Fatal("expected an error for an unsupported query type, got none")
+	}
+}
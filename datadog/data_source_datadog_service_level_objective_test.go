@@ -0,0 +1,64 @@
+package datadog
+
+import (
+	"testing"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+)
+
+func TestPrimarySLOThresholdFields_PrefersTopLevelFields(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+	slo.SetTimeframe(datadogV1.SLOTIMEFRAME_THIRTY_DAYS)
+	slo.SetTargetThreshold(99.9)
+	slo.SetWarningThreshold(99.95)
+
+	threshold := datadogV1.SLOThreshold{}
+	threshold.SetTimeframe(datadogV1.SLOTIMEFRAME_SEVEN_DAYS)
+	threshold.SetTarget(99.0)
+	threshold.SetWarning(99.5)
+	slo.SetThresholds([]datadogV1.SLOThreshold{threshold})
+
+	timeframe, target, warning := primarySLOThresholdFields(*slo)
+
+	if timeframe != string(datadogV1.SLOTIMEFRAME_THIRTY_DAYS) {
+		t.Errorf("expected timeframe %q, got %q", datadogV1.SLOTIMEFRAME_THIRTY_DAYS, timeframe)
+	}
+	if target != 99.9 {
+		t.Errorf("expected target 99.9, got %v", target)
+	}
+	if warning != 99.95 {
+		t.Errorf("expected warning 99.95, got %v", warning)
+	}
+}
+
+func TestPrimarySLOThresholdFields_FallsBackToFirstThreshold(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+
+	threshold := datadogV1.SLOThreshold{}
+	threshold.SetTimeframe(datadogV1.SLOTIMEFRAME_SEVEN_DAYS)
+	threshold.SetTarget(99.0)
+	threshold.SetWarning(99.5)
+	slo.SetThresholds([]datadogV1.SLOThreshold{threshold})
+
+	timeframe, target, warning := primarySLOThresholdFields(*slo)
+
+	if timeframe != string(datadogV1.SLOTIMEFRAME_SEVEN_DAYS) {
+		t.Errorf("expected timeframe %q, got %q", datadogV1.SLOTIMEFRAME_SEVEN_DAYS, timeframe)
+	}
+	if target != 99.0 {
+		t.Errorf("expected target 99.0, got %v", target)
+	}
+	if warning != 99.5 {
+		t.Errorf("expected warning 99.5, got %v", warning)
+	}
+}
+
+func TestPrimarySLOThresholdFields_NoThresholds(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+
+	timeframe, target, warning := primarySLOThresholdFields(*slo)
+
+	if timeframe != "" || target != 0 || warning != 0 {
+		t.Errorf("expected zero values with no thresholds, got timeframe=%q target=%v warning=%v", timeframe, target, warning)
+	}
+}
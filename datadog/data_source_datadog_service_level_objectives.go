@@ -0,0 +1,114 @@
+package datadog
+
+import (
+	"strings"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+const sloListPageSize = 1000
+
+func dataSourceDatadogServiceLevelObjectives() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve all Datadog service level objectives matching the search criteria, for use in other resources.",
+		Read:        dataSourceDatadogServiceLevelObjectivesRead,
+		Schema: map[string]*schema.Schema{
+			"name_query": {
+				Description: "The query string to filter results based on SLO names.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags_filter": {
+				Description: "The query string to filter results based on a single SLO tag.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"metrics_query": {
+				Description: "The query string to filter results based on SLO numerator and denominator.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "An array of SLO IDs to filter results on.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"slos": {
+				Description: "List of SLOs matching the search criteria.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        sloListItemResource(),
+			},
+		},
+	}
+}
+
+// isLastSLOPage reports whether a ListSLOs page shorter than the requested page size means
+// pagination is done.
+func isLastSLOPage(pageLen int, pageSize int64) bool {
+	return int64(pageLen) < pageSize
+}
+
+func dataSourceDatadogServiceLevelObjectivesRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	var ids []string
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			ids = append(ids, id.(string))
+		}
+	}
+
+	slos := make([]datadogV1.ServiceLevelObjective, 0)
+	offset := int64(0)
+	for {
+		req := datadogClientV1.ServiceLevelObjectivesApi.ListSLOs(authV1).
+			Limit(sloListPageSize).
+			Offset(offset)
+		if v, ok := d.GetOk("name_query"); ok {
+			req = req.Query(v.(string))
+		}
+		if v, ok := d.GetOk("tags_filter"); ok {
+			req = req.TagsQuery(v.(string))
+		}
+		if v, ok := d.GetOk("metrics_query"); ok {
+			req = req.MetricsQuery(v.(string))
+		}
+		if len(ids) > 0 {
+			req = req.Ids(strings.Join(ids, ","))
+		}
+
+		sloResponse, _, err := req.Execute()
+		if err != nil {
+			return utils.TranslateClientError(err, "error querying monitors")
+		}
+
+		page := sloResponse.GetData()
+		slos = append(slos, page...)
+		if isLastSLOPage(len(page), sloListPageSize) {
+			break
+		}
+		offset += sloListPageSize
+	}
+
+	matches := make([]map[string]interface{}, 0, len(slos))
+	for _, slo := range slos {
+		matches = append(matches, buildTerraformSLOListItem(slo))
+	}
+
+	if err := d.Set("slos", matches); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
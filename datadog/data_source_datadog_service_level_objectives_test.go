@@ -0,0 +1,24 @@
+package datadog
+
+import "testing"
+
+func TestIsLastSLOPage(t *testing.T) {
+	cases := []struct {
+		name     string
+		pageLen  int
+		pageSize int64
+		want     bool
+	}{
+		{"full page", 1000, 1000, false},
+		{"partial page", 42, 1000, true},
+		{"empty page", 0, 1000, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isLastSLOPage(c.pageLen, c.pageSize); got != c.want {
+				t.Errorf("isLastSLOPage(%d, %d) = %v, want %v", c.pageLen, c.pageSize, got, c.want)
+			}
+		})
+	}
+}
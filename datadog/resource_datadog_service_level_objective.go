@@ -0,0 +1,479 @@
+package datadog
+
+import (
+	"fmt"
+	"strconv"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func resourceDatadogServiceLevelObjective() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog service level objective resource. This can be used to create and manage Datadog service level objectives.",
+		Create:      resourceDatadogServiceLevelObjectiveCreate,
+		Read:        resourceDatadogServiceLevelObjectiveRead,
+		Update:      resourceDatadogServiceLevelObjectiveUpdate,
+		Delete:      resourceDatadogServiceLevelObjectiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of Datadog service level objective",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "A description of this service level objective.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Description: "A list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"thresholds": {
+				Description: "A list of thresholds and targets that define the service level objectives from the provided SLIs.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeframe": {
+							Description:  "The time frame for the objective. Available options to choose from are: `7d`, `30d`, `90d`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"7d", "30d", "90d"}, false),
+						},
+						"target": {
+							Description: "The objective's target in `[0,100]`.",
+							Type:        schema.TypeFloat,
+							Required:    true,
+						},
+						"target_display": {
+							Description: "A string representation of the target that indicates its precision. It uses trailing zeros to show significant decimal places (e.g. `98.00`).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"warning": {
+							Description: "The objective's warning value in `[0,100]`. This must be greater than the target value.",
+							Type:        schema.TypeFloat,
+							Optional:    true,
+						},
+						"warning_display": {
+							Description: "A string representation of the warning target (see the description of the target_display field for details).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"type": {
+				Description:  "The type of the service level objective. Available options to choose from are: `metric`, `monitor` and `time_slice`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(datadogV1.SLOTYPE_METRIC), string(datadogV1.SLOTYPE_MONITOR), string(datadogV1.SLOTYPE_TIME_SLICE)}, false),
+			},
+			"force_delete": {
+				Description: "A boolean indicating whether this monitor can be deleted even if itâ€™s referenced by other resources (e.g. dashboards).",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			// Metric-Based SLO
+			"query": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The metric query of good / total events",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"numerator": {
+							Description: "The sum of all the `good` events.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"denominator": {
+							Description: "The sum of the `total` events.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			// Monitor-Based SLO
+			"monitor_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A static set of monitor IDs to use as part of the SLO",
+				Elem:        &schema.Schema{Type: schema.TypeInt, MinItems: 1},
+			},
+			"groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A static set of groups to filter monitor-based SLOs",
+				Elem:        &schema.Schema{Type: schema.TypeString, MinItems: 1},
+			},
+			"validate": {
+				Description: "Whether or not to validate the SLO.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			// Time-Slice SLO
+			"sli_specification": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "A map of SLI specifications to compute the SLI value for time-slice SLOs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_slice": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Required:    true,
+							Description: "The time slice condition, representing the formula and threshold.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Required:    true,
+										Description: "The queries and formula used to calculate the SLI value.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"formula": {
+													Type:        schema.TypeList,
+													MaxItems:    1,
+													Required:    true,
+													Description: "The formula used to calculate the SLI value.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"formula_expression": {
+																Description: "The formula string, referencing the named queries.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+														},
+													},
+												},
+												"queries": {
+													Type:        schema.TypeList,
+													Required:    true,
+													MinItems:    1,
+													Description: "The named metric queries referenced by the formula.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Description: "The name of the query, used by the formula to refer to it.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+															"query": {
+																Description: "The metric query.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+															"data_source": {
+																Description: "The source of the queried metric.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"comparator": {
+										Description:  "The comparator used to compare the SLI value to the threshold. One of `>`, `>=`, `<`, `<=`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<="}, false),
+									},
+									"threshold": {
+										Description: "The threshold the SLI value is compared to.",
+										Type:        schema.TypeFloat,
+										Required:    true,
+									},
+									"query_interval_seconds": {
+										Description:  "The size of the window to evaluate for each time slice, in seconds. Available values: `60`, `300`.",
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntInSlice([]int{60, 300}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatadogServiceLevelObjectiveCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	sloRequest, err := buildDatadogServiceLevelObjective(d)
+	if err != nil {
+		return err
+	}
+
+	sloResponse, _, err := datadogClientV1.ServiceLevelObjectivesApi.CreateSLO(authV1).Body(*sloRequest).Execute()
+	if err != nil {
+		return utils.TranslateClientError(err, "error creating service level objective")
+	}
+
+	slos := sloResponse.GetData()
+	if len(slos) != 1 {
+		return fmt.Errorf("failed to create service level objective")
+	}
+
+	d.SetId(slos[0].GetId())
+
+	return resourceDatadogServiceLevelObjectiveRead(d, meta)
+}
+
+func resourceDatadogServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	sloResponse, httpResponse, err := datadogClientV1.ServiceLevelObjectivesApi.GetSLO(authV1, d.Id()).Execute()
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientError(err, "error getting service level objective")
+	}
+
+	slo := sloResponse.GetData()
+
+	thresholds := make([]map[string]interface{}, 0)
+	for _, threshold := range slo.GetThresholds() {
+		t := map[string]interface{}{
+			"timeframe": threshold.GetTimeframe(),
+			"target":    threshold.GetTarget(),
+		}
+		if warning, ok := threshold.GetWarningOk(); ok {
+			t["warning"] = *warning
+		}
+		if targetDisplay, ok := threshold.GetTargetDisplayOk(); ok {
+			t["target_display"] = *targetDisplay
+		}
+		if warningDisplay, ok := threshold.GetWarningDisplayOk(); ok {
+			t["warning_display"] = *warningDisplay
+		}
+		thresholds = append(thresholds, t)
+	}
+
+	if err := d.Set("name", slo.GetName()); err != nil {
+		return err
+	}
+	if err := d.Set("description", slo.GetDescription()); err != nil {
+		return err
+	}
+	if err := d.Set("type", slo.GetType()); err != nil {
+		return err
+	}
+	if err := d.Set("tags", slo.GetTags()); err != nil {
+		return err
+	}
+	if err := d.Set("thresholds", thresholds); err != nil {
+		return err
+	}
+
+	switch slo.GetType() {
+	case datadogV1.SLOTYPE_MONITOR:
+		if err := d.Set("monitor_ids", slo.GetMonitorIds()); err != nil {
+			return err
+		}
+		if err := d.Set("groups", slo.GetGroups()); err != nil {
+			return err
+		}
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		sliSpec := slo.GetSliSpecification()
+		terraformSliSpec, err := buildTerraformSliSpecification(sliSpec)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("sli_specification", []map[string]interface{}{terraformSliSpec}); err != nil {
+			return err
+		}
+	default:
+		q := slo.GetQuery()
+		query := map[string]interface{}{
+			"numerator":   q.GetNumerator(),
+			"denominator": q.GetDenominator(),
+		}
+		if err := d.Set("query", []map[string]interface{}{query}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDatadogServiceLevelObjectiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	sloRequest, err := buildDatadogServiceLevelObjective(d)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := datadogClientV1.ServiceLevelObjectivesApi.UpdateSLO(authV1, d.Id()).Body(*sloRequest).Execute(); err != nil {
+		return utils.TranslateClientError(err, "error updating service level objective")
+	}
+
+	return resourceDatadogServiceLevelObjectiveRead(d, meta)
+}
+
+func resourceDatadogServiceLevelObjectiveDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	req := datadogClientV1.ServiceLevelObjectivesApi.DeleteSLO(authV1, d.Id())
+	if d.Get("force_delete").(bool) {
+		req = req.Force(strconv.FormatBool(true))
+	}
+
+	if _, _, err := req.Execute(); err != nil {
+		return utils.TranslateClientError(err, "error deleting service level objective")
+	}
+
+	return nil
+}
+
+// buildDatadogServiceLevelObjective translates the Terraform config into the SDK's create/update
+// request body, sharing the thresholds/query/sli_specification shapes with the data source.
+func buildDatadogServiceLevelObjective(d *schema.ResourceData) (*datadogV1.ServiceLevelObjectiveRequest, error) {
+	sloType := datadogV1.SLOType(d.Get("type").(string))
+
+	thresholds := make([]datadogV1.SLOThreshold, 0)
+	for _, t := range d.Get("thresholds").(*schema.Set).List() {
+		tMap := t.(map[string]interface{})
+		threshold := datadogV1.SLOThreshold{}
+		threshold.SetTimeframe(datadogV1.SLOTimeframe(tMap["timeframe"].(string)))
+		threshold.SetTarget(tMap["target"].(float64))
+		if w, ok := tMap["warning"].(float64); ok && w != 0 {
+			threshold.SetWarning(w)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	sloRequest := datadogV1.NewServiceLevelObjectiveRequestWithDefaults()
+	sloRequest.SetName(d.Get("name").(string))
+	sloRequest.SetType(sloType)
+	sloRequest.SetThresholds(thresholds)
+	if description, ok := d.GetOk("description"); ok {
+		sloRequest.SetDescription(description.(string))
+	}
+	if validateSlo, ok := d.GetOkExists("validate"); ok {
+		sloRequest.SetValidate(validateSlo.(bool))
+	}
+
+	tags := make([]string, 0)
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+	sloRequest.SetTags(tags)
+
+	switch sloType {
+	case datadogV1.SLOTYPE_MONITOR:
+		monitorIds := make([]int64, 0)
+		for _, id := range d.Get("monitor_ids").(*schema.Set).List() {
+			monitorIds = append(monitorIds, int64(id.(int)))
+		}
+		sloRequest.SetMonitorIds(monitorIds)
+
+		groups := make([]string, 0)
+		for _, group := range d.Get("groups").(*schema.Set).List() {
+			groups = append(groups, group.(string))
+		}
+		sloRequest.SetGroups(groups)
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		sliSpec, err := buildDatadogSliSpecification(d)
+		if err != nil {
+			return nil, err
+		}
+		sloRequest.SetSliSpecification(*sliSpec)
+	default:
+		queryList := d.Get("query").([]interface{})
+		if len(queryList) != 1 {
+			return nil, fmt.Errorf("exactly one `query` block is required for metric-based SLOs")
+		}
+		queryMap := queryList[0].(map[string]interface{})
+		query := datadogV1.SLOQuery{}
+		query.SetNumerator(queryMap["numerator"].(string))
+		query.SetDenominator(queryMap["denominator"].(string))
+		sloRequest.SetQuery(query)
+	}
+
+	return sloRequest, nil
+}
+
+// buildDatadogSliSpecification converts the `sli_specification.0.time_slice.0` block into the
+// SDK's typed representation, mirroring the shape read back by buildTerraformSliSpecification.
+func buildDatadogSliSpecification(d *schema.ResourceData) (*datadogV1.SLOSliSpecification, error) {
+	sliSpecList := d.Get("sli_specification").([]interface{})
+	if len(sliSpecList) != 1 {
+		return nil, fmt.Errorf("exactly one `sli_specification` block is required for time-slice SLOs")
+	}
+	timeSliceList := sliSpecList[0].(map[string]interface{})["time_slice"].([]interface{})
+	if len(timeSliceList) != 1 {
+		return nil, fmt.Errorf("exactly one `sli_specification.time_slice` block is required")
+	}
+	timeSliceMap := timeSliceList[0].(map[string]interface{})
+
+	queryList := timeSliceMap["query"].([]interface{})
+	if len(queryList) != 1 {
+		return nil, fmt.Errorf("exactly one `sli_specification.time_slice.query` block is required")
+	}
+	queryMap := queryList[0].(map[string]interface{})
+
+	formulaList := queryMap["formula"].([]interface{})
+	if len(formulaList) != 1 {
+		return nil, fmt.Errorf("exactly one `sli_specification.time_slice.query.formula` block is required")
+	}
+	formulaExpression := formulaList[0].(map[string]interface{})["formula_expression"].(string)
+
+	queries := make([]datadogV1.SLOFormulaAndFunctionQueryDefinition, 0)
+	for _, q := range queryMap["queries"].([]interface{}) {
+		qMap := q.(map[string]interface{})
+		metricQuery := datadogV1.NewSLOFormulaAndFunctionMetricQueryDefinitionWithDefaults()
+		metricQuery.SetName(qMap["name"].(string))
+		metricQuery.SetQuery(qMap["query"].(string))
+		metricQuery.SetDataSource(datadogV1.SLOFormulaAndFunctionMetricDataSource(qMap["data_source"].(string)))
+		queries = append(queries, datadogV1.SLOFormulaAndFunctionQueryDefinition{
+			SLOFormulaAndFunctionMetricQueryDefinition: metricQuery,
+		})
+	}
+
+	sloFormulaQuery := datadogV1.SLOFormulaAndFunctionSliDataSourceQueryDefinition{}
+	sloFormulaQuery.SetFormulas([]datadogV1.SLOFormula{{Formula: formulaExpression}})
+	sloFormulaQuery.SetQueries(queries)
+
+	timeSlice := datadogV1.SLOTimeSliceCondition{}
+	timeSlice.SetQuery(sloFormulaQuery)
+	timeSlice.SetComparator(datadogV1.SLOTimeSliceComparator(timeSliceMap["comparator"].(string)))
+	timeSlice.SetThreshold(timeSliceMap["threshold"].(float64))
+	timeSlice.SetQueryIntervalSeconds(int64(timeSliceMap["query_interval_seconds"].(int)))
+
+	sliSpec := datadogV1.SLOSliSpecification{}
+	sliSpec.SetTimeSlice(timeSlice)
+
+	return &sliSpec, nil
+}
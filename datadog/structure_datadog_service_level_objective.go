@@ -0,0 +1,130 @@
+package datadog
+
+import (
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// sloListItemResource is the schema for a single entry of a `slos` list attribute, shared by the
+// `multiple` mode of the singular SLO data source and the plural `datadog_service_level_objectives`
+// data source so the two can't silently diverge.
+func sloListItemResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the Datadog service level objective.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the Datadog service level objective.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of the service level objective.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tags": {
+				Description: "A list of tags associated with the service level objective.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+			"thresholds": {
+				Description: "A list of thresholds and targets that define the service level objectives from the provided SLIs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeframe": {
+							Description: "The time frame for the objective.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target": {
+							Description: "The objective's target in `[0,100]`.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"warning": {
+							Description: "The objective's warning value in `[0,100]`.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"query": {
+				Description: "The metric query of good / total events for metric-based SLOs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"numerator": {
+							Description: "The sum of all the `good` events.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"denominator": {
+							Description: "The sum of the `total` events.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"monitor_ids": {
+				Description: "A static set of monitor IDs used as part of a monitor-based SLO.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"groups": {
+				Description: "A static set of groups used to filter a monitor-based SLO.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// buildTerraformSLOListItem flattens a single SLO into the shape described by sloListItemResource.
+func buildTerraformSLOListItem(slo datadogV1.ServiceLevelObjective) map[string]interface{} {
+	thresholds := make([]map[string]interface{}, 0)
+	for _, threshold := range slo.GetThresholds() {
+		thresholds = append(thresholds, map[string]interface{}{
+			"timeframe": threshold.GetTimeframe(),
+			"target":    threshold.GetTarget(),
+			"warning":   threshold.GetWarning(),
+		})
+	}
+
+	item := map[string]interface{}{
+		"id":         slo.GetId(),
+		"name":       slo.GetName(),
+		"type":       slo.GetType(),
+		"tags":       slo.GetTags(),
+		"thresholds": thresholds,
+	}
+
+	switch slo.GetType() {
+	case datadogV1.SLOTYPE_MONITOR:
+		item["monitor_ids"] = slo.GetMonitorIds()
+		item["groups"] = slo.GetGroups()
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		// no flat query/monitor_ids representation for time-slice SLOs
+	default:
+		q := slo.GetQuery()
+		item["query"] = []map[string]interface{}{
+			{
+				"numerator":   q.GetNumerator(),
+				"denominator": q.GetDenominator(),
+			},
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,65 @@
+package datadog
+
+import (
+	"testing"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+)
+
+func TestBuildTerraformSLOListItem_Monitor(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+	slo.SetId("abc123")
+	slo.SetName("my monitor slo")
+	slo.SetType(datadogV1.SLOTYPE_MONITOR)
+	slo.SetMonitorIds([]int64{1, 2, 3})
+	slo.SetGroups([]string{"env:prod"})
+
+	item := buildTerraformSLOListItem(*slo)
+
+	if item["id"] != "abc123" {
+		t.Errorf("unexpected id: %v", item["id"])
+	}
+	if _, ok := item["query"]; ok {
+		t.Errorf("did not expect a query block for a monitor-based SLO, got %v", item["query"])
+	}
+	monitorIds, ok := item["monitor_ids"].([]int64)
+	if !ok || len(monitorIds) != 3 {
+		t.Errorf("unexpected monitor_ids: %v", item["monitor_ids"])
+	}
+}
+
+func TestBuildTerraformSLOListItem_Metric(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+	slo.SetId("def456")
+	slo.SetType(datadogV1.SLOTYPE_METRIC)
+
+	query := datadogV1.SLOQuery{}
+	query.SetNumerator("sum:requests.good{*}")
+	query.SetDenominator("sum:requests.total{*}")
+	slo.SetQuery(query)
+
+	item := buildTerraformSLOListItem(*slo)
+
+	if _, ok := item["monitor_ids"]; ok {
+		t.Errorf("did not expect monitor_ids for a metric-based SLO, got %v", item["monitor_ids"])
+	}
+	queryList, ok := item["query"].([]map[string]interface{})
+	if !ok || len(queryList) != 1 || queryList[0]["numerator"] != "sum:requests.good{*}" {
+		t.Errorf("unexpected query: %v", item["query"])
+	}
+}
+
+func TestBuildTerraformSLOListItem_TimeSlice(t *testing.T) {
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+	slo.SetId("ghi789")
+	slo.SetType(datadogV1.SLOTYPE_TIME_SLICE)
+
+	item := buildTerraformSLOListItem(*slo)
+
+	if _, ok := item["query"]; ok {
+		t.Errorf("did not expect a flat query block for a time-slice SLO, got %v", item["query"])
+	}
+	if _, ok := item["monitor_ids"]; ok {
+		t.Errorf("did not expect monitor_ids for a time-slice SLO, got %v", item["monitor_ids"])
+	}
+}